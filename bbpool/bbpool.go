@@ -0,0 +1,72 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package bbpool provides a pool of *bytes.Buffer, so that parsing many
+// frames (or many files) doesn't churn the allocator with short-lived
+// scratch buffers.
+package bbpool
+
+import (
+	"bytes"
+	"sync"
+)
+
+var pool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// Get returns a buffer from the pool. The returned buffer is always
+// empty (Reset has been called on it).
+func Get() *bytes.Buffer {
+	return pool.Get().(*bytes.Buffer)
+}
+
+// Put resets buf and returns it to the pool.
+func Put(buf *bytes.Buffer) {
+	buf.Reset()
+	pool.Put(buf)
+}
+
+// sizedPools buckets buffers by capacity, so that GetSized(n) for a
+// small n doesn't hand back (and pin in memory) a buffer that was
+// previously grown for a much larger frame.
+var sizedPools = map[int]*sync.Pool{
+	4 << 10:  {New: func() interface{} { return new(bytes.Buffer) }},
+	64 << 10: {New: func() interface{} { return new(bytes.Buffer) }},
+	1 << 20:  {New: func() interface{} { return new(bytes.Buffer) }},
+	16 << 20: {New: func() interface{} { return new(bytes.Buffer) }},
+}
+
+// sizeClasses must stay sorted ascending: it's scanned for the smallest
+// bucket that fits n.
+var sizeClasses = []int{4 << 10, 64 << 10, 1 << 20, 16 << 20}
+
+// GetSized returns a buffer from the pool whose backing bucket is sized
+// to comfortably hold n bytes, pre-growing it so the caller's first
+// write(s) up to n bytes won't reallocate. Buffers larger than the
+// biggest bucket fall back to a plain allocation rather than being
+// pooled, so one oversized frame can't permanently bloat the pool.
+func GetSized(n int) *bytes.Buffer {
+	for _, class := range sizeClasses {
+		if n <= class {
+			buf := sizedPools[class].Get().(*bytes.Buffer)
+			buf.Grow(class)
+			return buf
+		}
+	}
+	buf := new(bytes.Buffer)
+	buf.Grow(n)
+	return buf
+}
+
+// PutSized returns buf, previously obtained from GetSized, to its
+// bucket. Buffers whose capacity doesn't match a known bucket (i.e. ones
+// GetSized allocated directly for an oversized request) are simply
+// dropped.
+func PutSized(buf *bytes.Buffer) {
+	buf.Reset()
+	if pool, ok := sizedPools[buf.Cap()]; ok {
+		pool.Put(buf)
+	}
+}
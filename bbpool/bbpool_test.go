@@ -0,0 +1,31 @@
+package bbpool
+
+import (
+	"bytes"
+	"testing"
+)
+
+// sink prevents the compiler from proving buf unused and eliding the
+// allocation in BenchmarkNewBuffer.
+var sink *bytes.Buffer
+
+// BenchmarkGetSized and BenchmarkNewBuffer compare pooled vs. freshly
+// allocated scratch buffers for the same repeated size, i.e. the common
+// case of parsing many same-sized frames. Run with -benchmem to see the
+// allocation difference; numbers aren't committed here since they're
+// machine-dependent.
+func BenchmarkGetSized(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		buf := GetSized(4000)
+		buf.Write(make([]byte, 4000))
+		PutSized(buf)
+	}
+}
+
+func BenchmarkNewBuffer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		buf := new(bytes.Buffer)
+		buf.Write(make([]byte, 4000))
+		sink = buf
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"github.com/bogem/id3v2/util"
+)
+
+// ChapterFrame represents the CHAP frame, defined by the ID3v2 Chapter
+// Addendum. It describes a single chapter of the file and may itself
+// contain sub-frames (e.g. a TIT2 frame giving the chapter's title).
+type ChapterFrame struct {
+	ElementID   string
+	StartTime   uint32
+	EndTime     uint32
+	StartOffset uint32
+	EndOffset   uint32
+	SubFrames   map[string]Framer
+}
+
+func parseChapterFrame(rd io.Reader, version byte, maxFrameSize int64, unsynchronisation bool) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	i := bytes.IndexByte(data, 0)
+	if i == -1 {
+		return nil, errors.New("CHAP frame: no element ID terminator found")
+	}
+	rest := data[i+1:]
+	if len(rest) < 16 {
+		return nil, errors.New("CHAP frame: body is too short for its timestamps/offsets")
+	}
+
+	subFrames, err := parseSubFrames(bytes.NewReader(rest[16:]), int64(len(rest)-16), version, maxFrameSize, unsynchronisation)
+	if err != nil {
+		return nil, err
+	}
+
+	return ChapterFrame{
+		ElementID:   string(data[:i]),
+		StartTime:   binary.BigEndian.Uint32(rest[0:4]),
+		EndTime:     binary.BigEndian.Uint32(rest[4:8]),
+		StartOffset: binary.BigEndian.Uint32(rest[8:12]),
+		EndOffset:   binary.BigEndian.Uint32(rest[12:16]),
+		SubFrames:   subFrames,
+	}, nil
+}
+
+func (chap ChapterFrame) Size() int {
+	size := len(chap.ElementID) + 1 + 16
+	for _, fr := range chap.SubFrames {
+		size += frameHeaderSize + fr.Size()
+	}
+	return size
+}
+
+func (chap ChapterFrame) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+
+	m, err := io.WriteString(w, chap.ElementID+"\x00")
+	n += int64(m)
+	if err != nil {
+		return n, err
+	}
+
+	var fixed [16]byte
+	binary.BigEndian.PutUint32(fixed[0:4], chap.StartTime)
+	binary.BigEndian.PutUint32(fixed[4:8], chap.EndTime)
+	binary.BigEndian.PutUint32(fixed[8:12], chap.StartOffset)
+	binary.BigEndian.PutUint32(fixed[12:16], chap.EndOffset)
+	written, err := w.Write(fixed[:])
+	n += int64(written)
+	if err != nil {
+		return n, err
+	}
+
+	for id, fr := range chap.SubFrames {
+		if err := validateFrameID(id); err != nil {
+			return n, err
+		}
+
+		header := make([]byte, frameHeaderSize)
+		copy(header[0:4], id)
+		copy(header[4:8], util.FormSize(int64(fr.Size())))
+
+		written, err = w.Write(header)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+
+		m64, err := fr.WriteTo(w)
+		n += m64
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestChapterFrameRoundTrip(t *testing.T) {
+	want := ChapterFrame{
+		ElementID:   "chp1",
+		StartTime:   0,
+		EndTime:     15000,
+		StartOffset: 0xFFFFFFFF,
+		EndOffset:   0xFFFFFFFF,
+		SubFrames: map[string]Framer{
+			"UFID": UFIDFrame{OwnerIdentifier: "owner", Identifier: []byte{1, 2, 3}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if buf.Len() != want.Size() {
+		t.Errorf("Size() = %d, but WriteTo wrote %d bytes", want.Size(), buf.Len())
+	}
+
+	fr, err := parseChapterFrame(bytes.NewReader(buf.Bytes()), 3, defaultMaxFrameSize, false)
+	if err != nil {
+		t.Fatalf("parseChapterFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseChapterFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+// TestParseChapterFrameThreadsUnsynchronisationToSubFrames guards against
+// a regression of the bug where parseSubFrames hardcoded the tag-level
+// unsynchronisation argument to false instead of threading it through
+// from the tag: a CHAP sub-frame whose payload contains an 0xFF 0x00
+// sequence must be de-unsynced exactly like a top-level frame when the
+// tag's unsynchronisation flag is set.
+func TestParseChapterFrameThreadsUnsynchronisationToSubFrames(t *testing.T) {
+	ufid := UFIDFrame{OwnerIdentifier: "owner", Identifier: []byte{0xFF, 0x00, 0x42}}
+	var subBuf bytes.Buffer
+	if _, err := ufid.WriteTo(&subBuf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+
+	subHeader := make([]byte, frameHeaderSize)
+	copy(subHeader[0:4], "UFID")
+	copy(subHeader[4:8], syncsafeSize(int64(subBuf.Len())))
+
+	body := append([]byte("ch1\x00"), make([]byte, 16)...)
+	body = append(body, subHeader...)
+	body = append(body, subBuf.Bytes()...)
+
+	fr, err := parseChapterFrame(bytes.NewReader(body), 3, defaultMaxFrameSize, true)
+	if err != nil {
+		t.Fatalf("parseChapterFrame: %v", err)
+	}
+
+	got, ok := fr.(ChapterFrame).SubFrames["UFID"].(UFIDFrame)
+	if !ok {
+		t.Fatalf("CHAP sub-frame UFID missing or wrong type: %+v", fr.(ChapterFrame).SubFrames)
+	}
+	want := []byte{0xFF, 0x42}
+	if !bytes.Equal(got.Identifier, want) {
+		t.Errorf("sub-frame Identifier = % x, want % x (tag-level unsynchronisation wasn't threaded through)", got.Identifier, want)
+	}
+}
+
+func TestParseChapterFrameBodyTooShort(t *testing.T) {
+	if _, err := parseChapterFrame(bytes.NewReader([]byte("ch1\x00short")), 3, defaultMaxFrameSize, false); err == nil {
+		t.Error("expected an error for a body too short for its timestamps/offsets")
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"compress/zlib"
+	"testing"
+)
+
+func TestParseFrameFlagsV24(t *testing.T) {
+	b := []byte{0x40 | 0x20 | 0x10, 0x40 | 0x08 | 0x04 | 0x02 | 0x01}
+	got := parseFrameFlags(b, 4)
+	want := frameFlags{
+		TagAlterPreservation:  true,
+		FileAlterPreservation: true,
+		ReadOnly:              true,
+		GroupingIdentity:      true,
+		Compression:           true,
+		Encryption:            true,
+		Unsynchronisation:     true,
+		DataLengthIndicator:   true,
+	}
+	if got != want {
+		t.Errorf("parseFrameFlags(v2.4) = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseFrameFlagsV23(t *testing.T) {
+	b := []byte{0x80 | 0x40 | 0x20, 0x80 | 0x40 | 0x20}
+	got := parseFrameFlags(b, 3)
+	want := frameFlags{
+		TagAlterPreservation:  true,
+		FileAlterPreservation: true,
+		ReadOnly:              true,
+		Compression:           true,
+		Encryption:            true,
+		GroupingIdentity:      true,
+	}
+	if got != want {
+		t.Errorf("parseFrameFlags(v2.3) = %+v, want %+v", got, want)
+	}
+}
+
+func TestDecodeUnsynchronisation(t *testing.T) {
+	in := []byte{0x41, 0xFF, 0x00, 0x42, 0xFF, 0x00, 0x00}
+	want := []byte{0x41, 0xFF, 0x42, 0xFF, 0x00}
+	got := decodeUnsynchronisation(in)
+	if !bytes.Equal(got, want) {
+		t.Errorf("decodeUnsynchronisation(% x) = % x, want % x", in, got, want)
+	}
+}
+
+func TestParseFrameBodyRejectsEncryptedFrame(t *testing.T) {
+	_, err := parseFrameBody(parseMusicCDIdentifierFrame, bytes.NewReader([]byte("x")), 1, frameFlags{Encryption: true}, false)
+	if err != errEncryptedFrame {
+		t.Errorf("err = %v, want errEncryptedFrame", err)
+	}
+}
+
+func TestParseFrameBodyDecompresses(t *testing.T) {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write([]byte("hello toc")); err != nil {
+		t.Fatalf("zlib Write: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zlib Close: %v", err)
+	}
+
+	fr, err := parseFrameBody(parseMusicCDIdentifierFrame, bytes.NewReader(compressed.Bytes()), int64(compressed.Len()), frameFlags{Compression: true}, false)
+	if err != nil {
+		t.Fatalf("parseFrameBody: %v", err)
+	}
+	if got := string(fr.(MusicCDIdentifierFrame).TOC); got != "hello toc" {
+		t.Errorf("TOC = %q, want %q", got, "hello toc")
+	}
+}
+
+func TestParseFrameBodyUndoesTagLevelUnsynchronisation(t *testing.T) {
+	body := []byte{0xFF, 0x00, 0x42}
+	fr, err := parseFrameBody(parseMusicCDIdentifierFrame, bytes.NewReader(body), int64(len(body)), frameFlags{}, true)
+	if err != nil {
+		t.Fatalf("parseFrameBody: %v", err)
+	}
+	want := []byte{0xFF, 0x42}
+	if got := fr.(MusicCDIdentifierFrame).TOC; !bytes.Equal(got, want) {
+		t.Errorf("TOC = % x, want % x", got, want)
+	}
+}
+
+func TestParseFrameBodyStripsDataLengthIndicator(t *testing.T) {
+	body := append([]byte{0, 0, 0, 9}, []byte("hi there!")...)
+	fr, err := parseFrameBody(parseMusicCDIdentifierFrame, bytes.NewReader(body), int64(len(body)), frameFlags{DataLengthIndicator: true}, false)
+	if err != nil {
+		t.Fatalf("parseFrameBody: %v", err)
+	}
+	if got := string(fr.(MusicCDIdentifierFrame).TOC); got != "hi there!" {
+		t.Errorf("TOC = %q, want %q", got, "hi there!")
+	}
+}
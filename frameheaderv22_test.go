@@ -0,0 +1,70 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseFrameHeaderV22UpgradesKnownID(t *testing.T) {
+	// "TT2" + a 3-byte plain (non-syncsafe) size of 16.
+	raw := []byte("TT2\x00\x00\x10")
+	header, err := parseFrameHeaderV22(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("parseFrameHeaderV22: %v", err)
+	}
+	if header.ID != "TIT2" {
+		t.Errorf("ID = %q, want %q", header.ID, "TIT2")
+	}
+	if header.FrameSize != 16 {
+		t.Errorf("FrameSize = %d, want 16", header.FrameSize)
+	}
+}
+
+func TestParseFrameHeaderV22PassesThroughUnmappedID(t *testing.T) {
+	raw := []byte("XYZ\x00\x00\x05")
+	header, err := parseFrameHeaderV22(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("parseFrameHeaderV22: %v", err)
+	}
+	if header.ID != "XYZ" {
+		t.Errorf("ID = %q, want %q (unmapped IDs pass through unchanged)", header.ID, "XYZ")
+	}
+}
+
+func TestParseSizeV22(t *testing.T) {
+	got := parseSizeV22([]byte{0x01, 0x02, 0x03})
+	want := int64(0x010203)
+	if got != want {
+		t.Errorf("parseSizeV22(%v) = %d, want %d", []byte{0x01, 0x02, 0x03}, got, want)
+	}
+}
+
+func TestV22ToV23IDsShapes(t *testing.T) {
+	for v22, v23 := range v22ToV23IDs {
+		if len(v22) != 3 {
+			t.Errorf("v22ToV23IDs has a non-3-char key %q", v22)
+		}
+		if len(v23) != 4 {
+			t.Errorf("v22ToV23IDs[%q] = %q is not 4 chars", v22, v23)
+		}
+	}
+}
+
+func TestV22ToV23IDsSpotChecks(t *testing.T) {
+	cases := map[string]string{
+		"TT2": "TIT2",
+		"PIC": "APIC",
+		"UFI": "UFID",
+		"TXX": "TXXX",
+		"COM": "COMM",
+	}
+	for v22, want := range cases {
+		if got := v22ToV23IDs[v22]; got != want {
+			t.Errorf("v22ToV23IDs[%q] = %q, want %q", v22, got, want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+// The frame names and IDs added here are identical between ID3v2.3 and
+// ID3v2.4, so they're registered in both V23IDs and V24IDs.
+func init() {
+	ids := map[string]string{
+		"Unique file identifier":              "UFID",
+		"User defined text information frame": "TXXX",
+		"User defined URL link frame":         "WXXX",
+		"Popularimeter":                       "POPM",
+		"Private frame":                       "PRIV",
+		"Music CD identifier":                 "MCDI",
+		"Synchronised lyric/text":             "SYLT",
+		"Chapter":                             "CHAP",
+		"Table of contents":                   "CTOC",
+	}
+
+	for name, id := range ids {
+		V23IDs[name] = id
+		V24IDs[name] = id
+	}
+}
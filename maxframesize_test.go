@@ -0,0 +1,103 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestParseReaderStrictAbortsOnOversizedFrame(t *testing.T) {
+	frames := concatFrames(frameBytes("MCDI", []byte("too long for the limit")))
+
+	_, err := ParseReader(bytes.NewReader(buildV23Tag(frames)), Options{MaxFrameSize: 5, ParseMode: Strict})
+	if err == nil {
+		t.Fatal("expected an error for a frame exceeding MaxFrameSize in Strict mode")
+	}
+}
+
+func TestParseReaderLenientSkipsOversizedFrameAndRecordsIt(t *testing.T) {
+	priv := PrivateFrame{Owner: "o", Data: []byte("ok")}
+	var privBuf bytes.Buffer
+	if _, err := priv.WriteTo(&privBuf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+
+	frames := concatFrames(
+		frameBytes("MCDI", []byte("too long for the limit")),
+		frameBytes("PRIV", privBuf.Bytes()),
+	)
+
+	tag, err := ParseReader(bytes.NewReader(buildV23Tag(frames)), Options{MaxFrameSize: 5, ParseMode: Lenient})
+	if err != nil {
+		t.Fatalf("ParseReader: %v", err)
+	}
+
+	errs := tag.Errors()
+	if len(errs) != 1 || errs[0].ID != "MCDI" || errs[0].Err != errFrameTooLarge {
+		t.Fatalf("Errors() = %+v, want a single MCDI errFrameTooLarge", errs)
+	}
+
+	if _, ok := tag.frames["PRIV"]; !ok {
+		t.Error("PRIV frame following the oversized MCDI frame was not parsed")
+	}
+}
+
+func TestParseFileLenientSkipsOversizedFrameAndRecordsIt(t *testing.T) {
+	priv := PrivateFrame{Owner: "o", Data: []byte("ok")}
+	var privBuf bytes.Buffer
+	if _, err := priv.WriteTo(&privBuf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+
+	frames := concatFrames(
+		frameBytes("MCDI", []byte("too long for the limit")),
+		frameBytes("PRIV", privBuf.Bytes()),
+	)
+
+	f, err := ioutil.TempFile("", "id3v2-maxframesize-*")
+	if err != nil {
+		t.Fatalf("TempFile: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err := f.Write(buildV23Tag(frames)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	tag, err := ParseFile(f.Name(), Options{MaxFrameSize: 5, ParseMode: Lenient})
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+
+	errs := tag.Errors()
+	if len(errs) != 1 || errs[0].ID != "MCDI" || errs[0].Err != errFrameTooLarge {
+		t.Fatalf("Errors() = %+v, want a single MCDI errFrameTooLarge", errs)
+	}
+
+	if _, ok := tag.frames["PRIV"]; !ok {
+		t.Error("PRIV frame following the oversized MCDI frame was not parsed")
+	}
+}
+
+func TestParseFileStrictAbortsOnOversizedFrame(t *testing.T) {
+	frames := concatFrames(frameBytes("MCDI", []byte("too long for the limit")))
+
+	f, err := ioutil.TempFile("", "id3v2-maxframesize-*")
+	if err != nil {
+		t.Fatalf("TempFile: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err := f.Write(buildV23Tag(frames)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if _, err := ParseFile(f.Name(), Options{MaxFrameSize: 5, ParseMode: Strict}); err == nil {
+		t.Fatal("expected an error for a frame exceeding MaxFrameSize in Strict mode")
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"io"
+	"io/ioutil"
+)
+
+// MusicCDIdentifierFrame represents the MCDI frame, which stores the
+// raw table of contents of the CD the file was ripped from.
+type MusicCDIdentifierFrame struct {
+	TOC []byte
+}
+
+func parseMusicCDIdentifierFrame(rd io.Reader) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+	return MusicCDIdentifierFrame{TOC: data}, nil
+}
+
+func (mcdi MusicCDIdentifierFrame) Size() int {
+	return len(mcdi.TOC)
+}
+
+func (mcdi MusicCDIdentifierFrame) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(mcdi.TOC)
+	return int64(n), err
+}
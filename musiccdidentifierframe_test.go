@@ -0,0 +1,43 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestMusicCDIdentifierFrameRoundTrip(t *testing.T) {
+	want := MusicCDIdentifierFrame{TOC: []byte{0x01, 0x02, 0x03, 0x04}}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if buf.Len() != want.Size() {
+		t.Errorf("Size() = %d, but WriteTo wrote %d bytes", want.Size(), buf.Len())
+	}
+
+	fr, err := parseMusicCDIdentifierFrame(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("parseMusicCDIdentifierFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseMusicCDIdentifierFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+func TestMusicCDIdentifierFrameEmptyTOC(t *testing.T) {
+	want := MusicCDIdentifierFrame{TOC: []byte{}}
+
+	fr, err := parseMusicCDIdentifierFrame(bytes.NewReader(nil))
+	if err != nil {
+		t.Fatalf("parseMusicCDIdentifierFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseMusicCDIdentifierFrame(empty) = %+v, want %+v", fr, want)
+	}
+}
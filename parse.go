@@ -5,22 +5,256 @@
 package id3v2
 
 import (
+	"bytes"
+	"compress/zlib"
 	"errors"
 	"io"
+	"io/ioutil"
 	"os"
 
 	"github.com/bogem/id3v2/bbpool"
 	"github.com/bogem/id3v2/util"
 )
 
-const frameHeaderSize = 10
+const (
+	frameHeaderSize    = 10
+	frameHeaderSizeV22 = 6
+)
+
+// v22ToV23IDs maps ID3v2.2's 3-char frame IDs to their v2.3 4-char
+// equivalents. Frame IDs are upgraded to v2.3 as soon as they're parsed,
+// so the rest of the package only ever has to deal with V23IDs/V24IDs.
+//
+// This covers every ID3v2.2 frame that has a v2.3 counterpart, not just
+// the handful of frames common in practice, since a frame ID this table
+// misses stays 3 chars long and Tag.writeFrame then refuses to write it
+// out (see validateFrameID).
+var v22ToV23IDs = map[string]string{
+	"BUF": "RBUF",
+	"CNT": "PCNT",
+	"COM": "COMM",
+	"CRA": "AENC",
+	"EQU": "EQUA",
+	"ETC": "ETCO",
+	"GEO": "GEOB",
+	"IPL": "IPLS",
+	"LNK": "LINK",
+	"MCI": "MCDI",
+	"MLL": "MLLT",
+	"PIC": "APIC",
+	"POP": "POPM",
+	"REV": "RVRB",
+	"RVA": "RVAD",
+	"SLT": "SYLT",
+	"STC": "SYTC",
+	"TAL": "TALB",
+	"TBP": "TBPM",
+	"TCM": "TCOM",
+	"TCO": "TCON",
+	"TCR": "TCOP",
+	"TDA": "TDAT",
+	"TDY": "TDLY",
+	"TEN": "TENC",
+	"TFT": "TFLT",
+	"TIM": "TIME",
+	"TKE": "TKEY",
+	"TLA": "TLAN",
+	"TLE": "TLEN",
+	"TMT": "TMED",
+	"TOA": "TOPE",
+	"TOF": "TOFN",
+	"TOL": "TOLY",
+	"TOR": "TORY",
+	"TOT": "TOAL",
+	"TP1": "TPE1",
+	"TP2": "TPE2",
+	"TP3": "TPE3",
+	"TP4": "TPE4",
+	"TPA": "TPOS",
+	"TPB": "TPUB",
+	"TRC": "TSRC",
+	"TRD": "TRDA",
+	"TRK": "TRCK",
+	"TSI": "TSIZ",
+	"TSS": "TSSE",
+	"TT1": "TIT1",
+	"TT2": "TIT2",
+	"TT3": "TIT3",
+	"TXT": "TEXT",
+	"TXX": "TXXX",
+	"TYE": "TYER",
+	"UFI": "UFID",
+	"ULT": "USLT",
+	"WAF": "WOAF",
+	"WAR": "WOAR",
+	"WAS": "WOAS",
+	"WCM": "WCOM",
+	"WCP": "WCOP",
+	"WPB": "WPUB",
+	"WXX": "WXXX",
+}
 
 type frameHeader struct {
 	ID        string
 	FrameSize int64
+	Flags     frameFlags
+}
+
+// frameFlags holds the decoded frame-header flag bits. ID3v2.2 frames
+// don't have any flags, so frameFlags is simply the zero value for them.
+// The bit positions of the remaining fields differ between v2.3 and
+// v2.4; see parseFrameFlags.
+type frameFlags struct {
+	TagAlterPreservation  bool
+	FileAlterPreservation bool
+	ReadOnly              bool
+	GroupingIdentity      bool
+	Compression           bool
+	Encryption            bool
+	Unsynchronisation     bool
+	DataLengthIndicator   bool
+}
+
+// parseFrameFlags decodes the two frame flag bytes that follow a v2.3 or
+// v2.4 frame's ID and size. Unsynchronisation and DataLengthIndicator
+// were only introduced in v2.4, so they're always false for v2.3 frames.
+func parseFrameFlags(b []byte, version byte) frameFlags {
+	if version == 4 {
+		return frameFlags{
+			TagAlterPreservation:  b[0]&0x40 != 0,
+			FileAlterPreservation: b[0]&0x20 != 0,
+			ReadOnly:              b[0]&0x10 != 0,
+			GroupingIdentity:      b[1]&0x40 != 0,
+			Compression:           b[1]&0x08 != 0,
+			Encryption:            b[1]&0x04 != 0,
+			Unsynchronisation:     b[1]&0x02 != 0,
+			DataLengthIndicator:   b[1]&0x01 != 0,
+		}
+	}
+
+	return frameFlags{
+		TagAlterPreservation:  b[0]&0x80 != 0,
+		FileAlterPreservation: b[0]&0x40 != 0,
+		ReadOnly:              b[0]&0x20 != 0,
+		Compression:           b[1]&0x80 != 0,
+		Encryption:            b[1]&0x40 != 0,
+		GroupingIdentity:      b[1]&0x20 != 0,
+	}
+}
+
+// errEncryptedFrame is returned by readFrame for frames with the
+// Encryption flag set, since we have no way to decrypt them.
+var errEncryptedFrame = errors.New("id3v2: encrypted frames are not supported")
+
+// ParseMode controls how a Tag reacts to a malformed frame.
+type ParseMode int
+
+const (
+	// Strict aborts parsing and returns the first frame error
+	// encountered. This is the default (the zero value), matching the
+	// package's historical all-or-nothing behaviour.
+	Strict ParseMode = iota
+
+	// Lenient skips frames that fail to parse instead of aborting.
+	// Skipped frames are recorded and can be inspected via Tag.Errors.
+	Lenient
+)
+
+// FrameError describes a single frame that failed to parse.
+type FrameError struct {
+	ID  string
+	Err error
+}
+
+func (e FrameError) Error() string {
+	return "id3v2: frame " + e.ID + ": " + e.Err.Error()
+}
+
+// errInvalidFrameID is returned by writeFrame (and the CHAP/CTOC
+// sub-frame writers) when asked to write a frame ID that isn't exactly
+// 4 bytes. A 3-byte ID silently truncated into a 4-byte header field
+// would otherwise write a corrupt frame ID, so we reject it instead.
+var errInvalidFrameID = errors.New("id3v2: frame ID must be exactly 4 bytes")
+
+func validateFrameID(id string) error {
+	if len(id) != 4 {
+		return errInvalidFrameID
+	}
+	return nil
+}
+
+// isPaddingID reports whether id consists entirely of null bytes, which
+// marks the start of a tag's padding rather than another frame.
+func isPaddingID(id string) bool {
+	for i := 0; i < len(id); i++ {
+		if id[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultMaxFrameSize bounds how large a single frame's declared size
+// may be, unless overridden via Options. It guards against a corrupt or
+// hostile FrameSize causing readFrame/parseFrameBody to buffer an
+// attacker-controlled amount of data.
+const defaultMaxFrameSize = 16 << 20 // 16 MiB
+
+// Options configures how ParseFile/ParseReader parse a tag.
+type Options struct {
+	// ParseMode controls how the parser reacts to a malformed frame.
+	// The zero value is Strict.
+	ParseMode ParseMode
+
+	// MaxFrameSize caps how large a single frame's declared size may
+	// be; frames claiming to be larger are rejected. Zero (the default)
+	// uses defaultMaxFrameSize.
+	MaxFrameSize int64
+}
+
+func (o Options) maxFrameSize() int64 {
+	if o.MaxFrameSize <= 0 {
+		return defaultMaxFrameSize
+	}
+	return o.MaxFrameSize
+}
+
+// errFrameTooLarge is returned, unwrapped (as a FrameError's Err for
+// top-level frames), when a frame's declared size exceeds the configured
+// MaxFrameSize.
+var errFrameTooLarge = errors.New("id3v2: frame size exceeds MaxFrameSize")
+
+// ParseFile opens path and parses its ID3v2 tag according to opts.
+func ParseFile(path string, opts Options) (*Tag, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseTagWithOptions(file, opts)
 }
 
 func parseTag(file *os.File) (*Tag, error) {
+	return parseTagWithOptions(file, Options{})
+}
+
+// readTagFlags reads the single flags byte at offset 5 of the 10-byte
+// tag header directly from file. Header (returned by parseHeader, in
+// header.go) only exposes Version and FramesSize, not the flags, so
+// this is the only way for the file-based path to learn whether
+// unsynchronisation is in effect; parseStreamHeader derives the same
+// bit from the equivalent byte for the streaming path.
+func readTagFlags(file *os.File) (byte, error) {
+	if _, err := file.Seek(5, os.SEEK_SET); err != nil {
+		return 0, err
+	}
+	var b [1]byte
+	if _, err := io.ReadFull(file, b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func parseTagWithOptions(file *os.File, opts Options) (*Tag, error) {
 	if file == nil {
 		err := errors.New("Invalid file: file is nil")
 		return nil, err
@@ -31,34 +265,51 @@ func parseTag(file *os.File) (*Tag, error) {
 		return nil, err
 	}
 	if header == nil {
-		return newTag(file, 0, 4), nil
+		return newTag(file, 0, 4, false, opts), nil
 	}
-	if header.Version < 3 {
+	if header.Version < 2 {
 		err = errors.New("Unsupported version of ID3 tag")
 		return nil, err
 	}
 
-	t := newTag(file, tagHeaderSize+header.FramesSize, header.Version)
-	err = t.findAllFrames()
+	flags, err := readTagFlags(file)
+	if err != nil {
+		err = errors.New("Trying to parse tag header: " + err.Error())
+		return nil, err
+	}
+
+	t := newTag(file, tagHeaderSize+header.FramesSize, header.Version, flags&0x80 != 0, opts)
+	if err := t.findAllFrames(); err != nil {
+		return nil, err
+	}
+	if err := t.parseAllFramesCoords(); err != nil {
+		return nil, err
+	}
 
-	return t, err
+	return t, nil
 }
 
-func newTag(file *os.File, originalSize int64, version byte) *Tag {
+func newTag(file *os.File, originalSize int64, version byte, unsynchronisation bool, opts Options) *Tag {
 	t := &Tag{
 		framesCoords: make(map[string][]frameCoordinates),
 		frames:       make(map[string]Framer),
 		sequences:    make(map[string]sequencer),
 
-		file:         file,
-		originalSize: originalSize,
-		version:      version,
+		file:              file,
+		originalSize:      originalSize,
+		version:           version,
+		unsynchronisation: unsynchronisation,
+		parseMode:         opts.ParseMode,
+		maxFrameSize:      opts.maxFrameSize(),
 	}
 
-	if version == 3 {
-		t.ids = V23IDs
-	} else {
+	if version == 4 {
 		t.ids = V24IDs
+	} else {
+		// v2.2 frame IDs are upgraded to v2.3 while parsing, so v2.2
+		// and v2.3 tags both look up frames in V23IDs. The tag is
+		// still written out as v2.3/v2.4, never re-downgraded to v2.2.
+		t.ids = V23IDs
 	}
 
 	return t
@@ -69,20 +320,59 @@ func (t *Tag) findAllFrames() error {
 	tagSize := t.originalSize
 	f := t.file
 
+	headerSize := int64(frameHeaderSize)
+	if t.version == 2 {
+		headerSize = frameHeaderSizeV22
+	}
+
 	for pos < tagSize {
 		if _, err := f.Seek(pos, os.SEEK_SET); err != nil {
 			return err
 		}
 
-		header, err := parseFrameHeader(f)
+		header, err := parseFrameHeader(f, t.version)
 		if err != nil {
-			return err
+			// We don't know this frame's ID or size, so there's no
+			// way to resync to the next frame: stop scanning rather
+			// than risk misinterpreting whatever follows.
+			frameErr := FrameError{ID: "", Err: err}
+			if t.parseMode == Strict {
+				return frameErr
+			}
+			t.frameErrors = append(t.frameErrors, frameErr)
+			break
+		}
+		if isPaddingID(header.ID) {
+			// We've reached the tag's padding: there are no more
+			// frames left to find.
+			break
+		}
+		pos += headerSize
+
+		if header.FrameSize > t.maxFrameSize {
+			// The header itself is trustworthy, so we can skip
+			// exactly this frame's declared bytes and keep scanning.
+			frameErr := FrameError{ID: header.ID, Err: errFrameTooLarge}
+			if t.parseMode == Strict {
+				return frameErr
+			}
+			t.frameErrors = append(t.frameErrors, frameErr)
+			pos += header.FrameSize
+			continue
+		}
+		if pos+header.FrameSize > tagSize {
+			frameErr := FrameError{ID: header.ID, Err: errors.New("id3v2: frame size extends past the end of the tag")}
+			if t.parseMode == Strict {
+				return frameErr
+			}
+			t.frameErrors = append(t.frameErrors, frameErr)
+			break
 		}
-		pos += frameHeaderSize
 
 		fc := frameCoordinates{
-			Len: header.FrameSize,
-			Pos: pos,
+			Len:   header.FrameSize,
+			Pos:   pos,
+			Flags: header.Flags,
 		}
 		fcs := t.framesCoords[header.ID]
 		fcs = append(fcs, fc)
@@ -94,7 +384,11 @@ func (t *Tag) findAllFrames() error {
 	return nil
 }
 
-func parseFrameHeader(rd io.Reader) (*frameHeader, error) {
+func parseFrameHeader(rd io.Reader, version byte) (*frameHeader, error) {
+	if version == 2 {
+		return parseFrameHeaderV22(rd)
+	}
+
 	fhBuf := bbpool.Get()
 	defer bbpool.Put(fhBuf)
 
@@ -104,6 +398,14 @@ func parseFrameHeader(rd io.Reader) (*frameHeader, error) {
 	if err != nil {
 		return nil, err
 	}
+	if n == 0 {
+		// bytes.Buffer.ReadFrom treats io.EOF as a normal end of input,
+		// not an error, so a clean "no more frames" has to be detected
+		// here instead of by checking err: this is the only case callers
+		// (readAllFrames, parseSubFrames) can tell apart from a frame
+		// header that was merely cut short.
+		return nil, io.EOF
+	}
 	if n < frameHeaderSize {
 		return nil, errors.New("Size of frame header is less than expected")
 	}
@@ -113,58 +415,381 @@ func parseFrameHeader(rd io.Reader) (*frameHeader, error) {
 	header := &frameHeader{
 		ID:        string(byteHeader[:4]),
 		FrameSize: util.ParseSize(byteHeader[4:8]),
+		Flags:     parseFrameFlags(byteHeader[8:10], version),
 	}
 
 	return header, nil
 
 }
 
-func (t *Tag) parseAllFramesCoords() {
+// parseFrameHeaderV22 parses a 6-byte ID3v2.2 frame header: a 3-char
+// frame ID followed by a 3-byte, non-syncsafe size. The ID is upgraded
+// to its v2.3 equivalent via v22ToV23IDs before it's returned.
+func parseFrameHeaderV22(rd io.Reader) (*frameHeader, error) {
+	fhBuf := bbpool.Get()
+	defer bbpool.Put(fhBuf)
+
+	limitedRd := &io.LimitedReader{R: rd, N: frameHeaderSizeV22}
+
+	n, err := fhBuf.ReadFrom(limitedRd)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, io.EOF
+	}
+	if n < frameHeaderSizeV22 {
+		return nil, errors.New("Size of frame header is less than expected")
+	}
+
+	byteHeader := fhBuf.Bytes()
+
+	id := string(byteHeader[:3])
+	if v23ID, ok := v22ToV23IDs[id]; ok {
+		id = v23ID
+	}
+
+	header := &frameHeader{
+		ID:        id,
+		FrameSize: parseSizeV22(byteHeader[3:6]),
+	}
+
+	return header, nil
+}
+
+// parseSizeV22 parses the plain (non-syncsafe) 3-byte big-endian size
+// used by ID3v2.2 frame headers.
+func parseSizeV22(b []byte) int64 {
+	return int64(b[0])<<16 | int64(b[1])<<8 | int64(b[2])
+}
+
+func (t *Tag) parseAllFramesCoords() error {
 	for id := range t.framesCoords {
-		t.parseFramesCoordsWithID(id)
+		if err := t.parseFramesCoordsWithID(id); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-func (t *Tag) parseFramesCoordsWithID(id string) {
+func (t *Tag) parseFramesCoordsWithID(id string) error {
 	fcs, exists := t.framesCoords[id]
 	if !exists {
-		return
+		return nil
 	}
 
 	parseFunc := t.findParseFunc(id)
 	if parseFunc != nil {
 		for _, fc := range fcs {
-			fr := readFrame(parseFunc, t.file, fc)
+			fr, err := readFrame(parseFunc, t.file, fc, t.unsynchronisation)
+			if err != nil {
+				frameErr := FrameError{ID: id, Err: err}
+				if t.parseMode == Strict {
+					return frameErr
+				}
+				t.frameErrors = append(t.frameErrors, frameErr)
+				continue
+			}
 			t.AddFrame(id, fr)
 		}
 	}
 	// Delete frames with id from t.framesCoords,
 	// because they are just being parsed
 	delete(t.framesCoords, id)
+	return nil
+}
+
+// Errors returns the frame errors accumulated while parsing in Lenient
+// mode. It's always empty in Strict mode, since the first error aborts
+// parsing instead of being recorded.
+func (t *Tag) Errors() []FrameError {
+	return t.frameErrors
 }
 
 func (t Tag) findParseFunc(id string) func(io.Reader) (Framer, error) {
-	if id[0] == 'T' {
-		return parseTextFrame
+	return findParseFuncForID(id, t.ids, t.version, t.maxFrameSize, t.unsynchronisation)
+}
+
+// idsForVersion returns the frame name-to-ID map for the given tag
+// version, the same way newTag picks t.ids.
+func idsForVersion(version byte) map[string]string {
+	if version == 4 {
+		return V24IDs
 	}
+	return V23IDs
+}
 
+// findParseFuncForID is the Tag-independent half of findParseFunc: it
+// only needs a name-to-ID map, not a whole Tag, so CHAP/CTOC can also use
+// it to parse their embedded sub-frames. version is threaded through to
+// CHAP/CTOC so their sub-frames' flag bytes are decoded with the right
+// v2.3/v2.4 bit layout instead of always assuming v2.3, maxFrameSize so
+// the bounded-memory guarantee from Options.MaxFrameSize applies to
+// sub-frames too, and unsynchronisation so a sub-frame payload is
+// de-unsynced exactly like a top-level one when the tag sets the
+// tag-level unsynchronisation flag.
+func findParseFuncForID(id string, ids map[string]string, version byte, maxFrameSize int64, unsynchronisation bool) func(io.Reader) (Framer, error) {
 	switch id {
-	case t.ID("Attached picture"):
+	case ids["Attached picture"]:
 		return parsePictureFrame
-	case t.ID("Comments"):
+	case ids["Comments"]:
 		return parseCommentFrame
-	case t.ID("Unsynchronised lyrics/text transcription"):
+	case ids["Unsynchronised lyrics/text transcription"]:
 		return parseUnsynchronisedLyricsFrame
+	case ids["Unique file identifier"]:
+		return parseUFIDFrame
+	case ids["User defined text information frame"]:
+		return parseUserDefinedTextFrame
+	case ids["User defined URL link frame"]:
+		return parseUserDefinedURLFrame
+	case ids["Popularimeter"]:
+		return parsePopularimeterFrame
+	case ids["Private frame"]:
+		return parsePrivateFrame
+	case ids["Music CD identifier"]:
+		return parseMusicCDIdentifierFrame
+	case ids["Synchronised lyric/text"]:
+		return parseSynchronisedLyricsFrame
+	case ids["Chapter"]:
+		return func(rd io.Reader) (Framer, error) {
+			return parseChapterFrame(rd, version, maxFrameSize, unsynchronisation)
+		}
+	case ids["Table of contents"]:
+		return func(rd io.Reader) (Framer, error) {
+			return parseTableOfContentsFrame(rd, version, maxFrameSize, unsynchronisation)
+		}
 	}
+
+	if id[0] == 'T' {
+		return parseTextFrame
+	}
+
 	return nil
 }
 
-func readFrame(parseFunc func(io.Reader) (Framer, error), rs io.ReadSeeker, fc frameCoordinates) Framer {
-	rs.Seek(fc.Pos, os.SEEK_SET)
-	rd := &io.LimitedReader{R: rs, N: fc.Len}
-	fr, err := parseFunc(rd)
+// parseSubFrames parses the frames embedded in a CHAP or CTOC frame's
+// body, bounded by size. Embedded frames use the same header format as
+// top-level ones.
+func parseSubFrames(r io.Reader, size int64, version byte, maxFrameSize int64, unsynchronisation bool) (map[string]Framer, error) {
+	lr := &io.LimitedReader{R: r, N: size}
+	ids := idsForVersion(version)
+	frames := make(map[string]Framer)
+
+	for lr.N > 0 {
+		header, err := parseFrameHeader(lr, version)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if isPaddingID(header.ID) {
+			break
+		}
+		if header.FrameSize > maxFrameSize {
+			return nil, errFrameTooLarge
+		}
+
+		parseFunc := findParseFuncForID(header.ID, ids, version, maxFrameSize, unsynchronisation)
+		if parseFunc == nil {
+			if _, err := io.CopyN(ioutil.Discard, lr, header.FrameSize); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		fr, err := parseFrameBody(parseFunc, lr, header.FrameSize, header.Flags, unsynchronisation)
+		if err != nil {
+			return nil, err
+		}
+		frames[header.ID] = fr
+	}
+
+	return frames, nil
+}
+
+// readFrame reads a single frame's raw bytes at fc and hands them to
+// parseFunc, after undoing whatever the frame's flags (and, when the tag
+// header sets it, the tag-level unsynchronisation scheme) did to the
+// payload on write.
+func readFrame(parseFunc func(io.Reader) (Framer, error), rs io.ReadSeeker, fc frameCoordinates, tagUnsynchronisation bool) (Framer, error) {
+	if _, err := rs.Seek(fc.Pos, os.SEEK_SET); err != nil {
+		return nil, err
+	}
+	return parseFrameBody(parseFunc, rs, fc.Len, fc.Flags, tagUnsynchronisation)
+}
+
+// parseFrameBody reads a frame's size bytes from r and hands them to
+// parseFunc, after undoing whatever the frame's flags (and, when the tag
+// header sets it, the tag-level unsynchronisation scheme) did to the
+// payload on write. It's the part of frame decoding that's the same
+// whether the size bytes come from a seekable file (readFrame) or a
+// plain stream (Tag.readAllFrames).
+func parseFrameBody(parseFunc func(io.Reader) (Framer, error), r io.Reader, size int64, flags frameFlags, tagUnsynchronisation bool) (Framer, error) {
+	if flags.Encryption {
+		return nil, errEncryptedFrame
+	}
+
+	buf := bbpool.GetSized(int(size))
+	defer bbpool.PutSized(buf)
+	if _, err := io.CopyN(buf, r, size); err != nil {
+		return nil, err
+	}
+	payload := buf.Bytes()
+
+	if flags.DataLengthIndicator {
+		// The leading 4 syncsafe bytes give the frame's true
+		// (decompressed) size. We already know how much to read from
+		// size, so they just need to be stripped off here.
+		payload = payload[4:]
+	}
+
+	if tagUnsynchronisation || flags.Unsynchronisation {
+		payload = decodeUnsynchronisation(payload)
+	}
+
+	rd := io.Reader(bytes.NewReader(payload))
+
+	if flags.Compression {
+		zr, err := zlib.NewReader(rd)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		rd = zr
+	}
+
+	return parseFunc(rd)
+}
+
+// decodeUnsynchronisation reverses the ID3v2 unsynchronisation scheme
+// ($FF 00 -> $FF) applied to the given bytes.
+func decodeUnsynchronisation(b []byte) []byte {
+	decoded := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] == 0xFF && i+1 < len(b) && b[i+1] == 0x00 {
+			decoded = append(decoded, 0xFF)
+			i++
+			continue
+		}
+		decoded = append(decoded, b[i])
+	}
+	return decoded
+}
+
+// streamHeader is the subset of the tag header that ParseReader needs.
+// It's deliberately separate from the type parseHeader returns: that one
+// is read via Seek, while this one is read from a plain io.Reader.
+type streamHeader struct {
+	Version           byte
+	FramesSize        int64
+	Unsynchronisation bool
+}
+
+func parseStreamHeader(r io.Reader) (*streamHeader, error) {
+	buf := bbpool.Get()
+	defer bbpool.Put(buf)
+
+	if _, err := io.CopyN(buf, r, tagHeaderSize); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	b := buf.Bytes()
+	if string(b[:3]) != "ID3" {
+		return nil, nil
+	}
+
+	return &streamHeader{
+		Version:           b[3],
+		Unsynchronisation: b[5]&0x80 != 0,
+		FramesSize:        util.ParseSize(b[6:10]),
+	}, nil
+}
+
+// ParseReader parses an ID3v2 tag from r without requiring Seek, so tags
+// can be decoded off HTTP bodies, tar entries, piped stdin, and other
+// non-seekable sources.
+//
+// Unlike parseTag, it doesn't build a framesCoords map first, since that
+// relies on seeking back into the file: each frame is parsed as soon as
+// its bytes are read.
+func ParseReader(r io.Reader, opts Options) (*Tag, error) {
+	header, err := parseStreamHeader(r)
 	if err != nil {
-		panic(err)
+		return nil, errors.New("Trying to parse tag header: " + err.Error())
+	}
+	if header == nil {
+		return newTag(nil, 0, 4, false, opts), nil
+	}
+	if header.Version < 2 {
+		return nil, errors.New("Unsupported version of ID3 tag")
+	}
+
+	t := newTag(nil, tagHeaderSize+header.FramesSize, header.Version, header.Unsynchronisation, opts)
+	if err := t.readAllFrames(io.LimitReader(r, header.FramesSize)); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// readAllFrames is the sequential, non-seeking counterpart of
+// findAllFrames+parseAllFramesCoords: each frame is parsed into a Framer
+// as soon as its bytes are read, rather than being recorded as
+// coordinates for later.
+func (t *Tag) readAllFrames(r io.Reader) error {
+	for {
+		header, err := parseFrameHeader(r, t.version)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			// We don't know this frame's ID or size, and unlike the
+			// seekable file path there's no coordinate to skip to
+			// anyway: stop reading rather than risk desyncing on
+			// whatever bytes follow.
+			frameErr := FrameError{ID: "", Err: err}
+			if t.parseMode == Strict {
+				return frameErr
+			}
+			t.frameErrors = append(t.frameErrors, frameErr)
+			return nil
+		}
+		if isPaddingID(header.ID) {
+			return nil
+		}
+		if header.FrameSize > t.maxFrameSize {
+			frameErr := FrameError{ID: header.ID, Err: errFrameTooLarge}
+			if t.parseMode == Strict {
+				return frameErr
+			}
+			t.frameErrors = append(t.frameErrors, frameErr)
+			if _, err := io.CopyN(ioutil.Discard, r, header.FrameSize); err != nil {
+				return err
+			}
+			continue
+		}
+
+		parseFunc := t.findParseFunc(header.ID)
+		if parseFunc == nil {
+			if _, err := io.CopyN(ioutil.Discard, r, header.FrameSize); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fr, err := parseFrameBody(parseFunc, r, header.FrameSize, header.Flags, t.unsynchronisation)
+		if err != nil {
+			frameErr := FrameError{ID: header.ID, Err: err}
+			if t.parseMode == Strict {
+				return frameErr
+			}
+			t.frameErrors = append(t.frameErrors, frameErr)
+			continue
+		}
+		t.AddFrame(header.ID, fr)
 	}
-	return fr
 }
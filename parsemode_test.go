@@ -0,0 +1,99 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+// syncsafeSize encodes n as a 4-byte syncsafe integer (7 significant bits
+// per byte), the same format util.FormSize produces for tag and frame
+// sizes. It's reimplemented here rather than imported so these tests
+// don't depend on anything outside this package.
+func syncsafeSize(n int64) []byte {
+	return []byte{
+		byte((n >> 21) & 0x7f),
+		byte((n >> 14) & 0x7f),
+		byte((n >> 7) & 0x7f),
+		byte(n & 0x7f),
+	}
+}
+
+// concatFrames joins already-encoded frames into a single frames blob.
+func concatFrames(frames ...[]byte) []byte {
+	var buf []byte
+	for _, f := range frames {
+		buf = append(buf, f...)
+	}
+	return buf
+}
+
+// frameBytes encodes a single v2.3/v2.4-style frame: a 4-char ID, a
+// syncsafe size, two zero flag bytes, and body.
+func frameBytes(id string, body []byte) []byte {
+	b := make([]byte, frameHeaderSize)
+	copy(b[0:4], id)
+	copy(b[4:8], syncsafeSize(int64(len(body))))
+	return append(b, body...)
+}
+
+// buildV23Tag wraps frames in a minimal v2.3 tag header, for feeding to
+// ParseReader.
+func buildV23Tag(frames []byte) []byte {
+	header := make([]byte, tagHeaderSize)
+	copy(header[0:3], "ID3")
+	header[3] = 3
+	header[4] = 0
+	header[5] = 0
+	copy(header[6:10], syncsafeSize(int64(len(frames))))
+	return append(header, frames...)
+}
+
+func TestParseReaderStrictAbortsOnFirstBadFrame(t *testing.T) {
+	frames := concatFrames(
+		frameBytes("TXXX", nil), // empty body: parseUserDefinedTextFrame rejects this
+		frameBytes("MCDI", []byte("toc")),
+	)
+
+	tag, err := ParseReader(bytes.NewReader(buildV23Tag(frames)), Options{ParseMode: Strict})
+	if err == nil {
+		t.Fatal("expected an error in Strict mode, got nil")
+	}
+	if tag != nil {
+		t.Errorf("expected a nil tag alongside the error, got %+v", tag)
+	}
+}
+
+func TestParseReaderLenientSkipsBadFrameAndRecordsIt(t *testing.T) {
+	frames := concatFrames(
+		frameBytes("TXXX", nil),
+		frameBytes("MCDI", []byte("toc")),
+	)
+
+	tag, err := ParseReader(bytes.NewReader(buildV23Tag(frames)), Options{ParseMode: Lenient})
+	if err != nil {
+		t.Fatalf("ParseReader: %v", err)
+	}
+
+	errs := tag.Errors()
+	if len(errs) != 1 || errs[0].ID != "TXXX" {
+		t.Fatalf("Errors() = %+v, want a single TXXX error", errs)
+	}
+
+	fr, ok := tag.frames["MCDI"]
+	if !ok {
+		t.Fatal("MCDI frame following the bad TXXX frame was not parsed")
+	}
+	if got := string(fr.(MusicCDIdentifierFrame).TOC); got != "toc" {
+		t.Errorf("MCDI TOC = %q, want %q", got, "toc")
+	}
+}
+
+func TestParseReaderStrictIsTheZeroValue(t *testing.T) {
+	if ParseMode(0) != Strict {
+		t.Errorf("Strict = %v, want the zero value", Strict)
+	}
+}
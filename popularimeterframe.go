@@ -0,0 +1,72 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// PopularimeterFrame represents the POPM frame, which stores a rating
+// and play counter for the file on behalf of a given email address.
+type PopularimeterFrame struct {
+	Email   string
+	Rating  byte
+	Counter uint64
+}
+
+func parsePopularimeterFrame(rd io.Reader) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	i := bytes.IndexByte(data, 0)
+	if i == -1 {
+		return nil, errors.New("POPM frame: no email terminator found")
+	}
+	rest := data[i+1:]
+	if len(rest) < 1 {
+		return nil, errors.New("POPM frame: body is too short for a rating")
+	}
+
+	// The play counter is an optional, variable-length big-endian
+	// integer occupying the rest of the frame.
+	var counter uint64
+	for _, b := range rest[1:] {
+		counter = counter<<8 | uint64(b)
+	}
+
+	return PopularimeterFrame{
+		Email:   string(data[:i]),
+		Rating:  rest[0],
+		Counter: counter,
+	}, nil
+}
+
+func (popm PopularimeterFrame) Size() int {
+	size := len(popm.Email) + 1 + 1
+	for c := popm.Counter; c > 0; c >>= 8 {
+		size++
+	}
+	return size
+}
+
+func (popm PopularimeterFrame) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, popm.Email+"\x00")
+	if err != nil {
+		return int64(n), err
+	}
+
+	var counterBytes []byte
+	for c := popm.Counter; c > 0; c >>= 8 {
+		counterBytes = append([]byte{byte(c)}, counterBytes...)
+	}
+
+	m, err := w.Write(append([]byte{popm.Rating}, counterBytes...))
+	return int64(n + m), err
+}
@@ -0,0 +1,49 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestPopularimeterFrameRoundTrip(t *testing.T) {
+	cases := []PopularimeterFrame{
+		{Email: "user@example.com", Rating: 128, Counter: 0},
+		{Email: "user@example.com", Rating: 255, Counter: 42},
+		{Email: "user@example.com", Rating: 1, Counter: 1 << 40},
+	}
+
+	for _, want := range cases {
+		var buf bytes.Buffer
+		if _, err := want.WriteTo(&buf); err != nil {
+			t.Fatalf("WriteTo(%+v): %v", want, err)
+		}
+		if buf.Len() != want.Size() {
+			t.Errorf("Size() = %d, but WriteTo wrote %d bytes for %+v", want.Size(), buf.Len(), want)
+		}
+
+		fr, err := parsePopularimeterFrame(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatalf("parsePopularimeterFrame(%+v): %v", want, err)
+		}
+		if !reflect.DeepEqual(fr, want) {
+			t.Errorf("parsePopularimeterFrame(WriteTo(%+v)) = %+v, want %+v", want, fr, want)
+		}
+	}
+}
+
+func TestParsePopularimeterFrameMissingRating(t *testing.T) {
+	if _, err := parsePopularimeterFrame(bytes.NewReader([]byte("user@example.com\x00"))); err == nil {
+		t.Error("expected an error for a body with no rating byte")
+	}
+}
+
+func TestParsePopularimeterFrameMissingTerminator(t *testing.T) {
+	if _, err := parsePopularimeterFrame(bytes.NewReader([]byte("no terminator"))); err == nil {
+		t.Error("expected an error for a body with no email terminator")
+	}
+}
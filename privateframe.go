@@ -0,0 +1,49 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// PrivateFrame represents the PRIV frame, which carries binary data
+// meaningful only to the software that put it there.
+type PrivateFrame struct {
+	Owner string
+	Data  []byte
+}
+
+func parsePrivateFrame(rd io.Reader) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	i := bytes.IndexByte(data, 0)
+	if i == -1 {
+		return nil, errors.New("PRIV frame: no owner identifier terminator found")
+	}
+
+	return PrivateFrame{
+		Owner: string(data[:i]),
+		Data:  data[i+1:],
+	}, nil
+}
+
+func (priv PrivateFrame) Size() int {
+	return len(priv.Owner) + 1 + len(priv.Data)
+}
+
+func (priv PrivateFrame) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, priv.Owner+"\x00")
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := w.Write(priv.Data)
+	return int64(n + m), err
+}
@@ -0,0 +1,40 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestPrivateFrameRoundTrip(t *testing.T) {
+	want := PrivateFrame{
+		Owner: "com.apple.iTunes",
+		Data:  []byte{0xDE, 0xAD, 0xBE, 0xEF},
+	}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if buf.Len() != want.Size() {
+		t.Errorf("Size() = %d, but WriteTo wrote %d bytes", want.Size(), buf.Len())
+	}
+
+	fr, err := parsePrivateFrame(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("parsePrivateFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parsePrivateFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+func TestParsePrivateFrameMissingTerminator(t *testing.T) {
+	if _, err := parsePrivateFrame(bytes.NewReader([]byte("no terminator"))); err == nil {
+		t.Error("expected an error for a body with no owner identifier terminator")
+	}
+}
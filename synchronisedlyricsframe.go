@@ -0,0 +1,125 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// SyncedText is a single lyrics/text line of a SynchronisedLyricsFrame,
+// paired with the timestamp it should be shown at.
+type SyncedText struct {
+	Text      string
+	Timestamp uint32
+}
+
+// SynchronisedLyricsFrame represents the SYLT frame: lyrics or text
+// transcription synchronised with the audio by timestamp.
+type SynchronisedLyricsFrame struct {
+	Encoding          byte
+	Language          string
+	TimestampFormat   byte
+	ContentType       byte
+	ContentDescriptor string
+	SyncedTexts       []SyncedText
+}
+
+func parseSynchronisedLyricsFrame(rd io.Reader) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 6 {
+		return nil, errors.New("SYLT frame: body is too short for its fixed fields")
+	}
+
+	encoding := data[0]
+	language := string(data[1:4])
+	timestampFormat := data[4]
+	contentType := data[5]
+	rest := data[6:]
+
+	i := bytes.IndexByte(rest, 0)
+	if i == -1 {
+		return nil, errors.New("SYLT frame: no content descriptor terminator found")
+	}
+	contentDescriptor := string(rest[:i])
+	rest = rest[i+1:]
+
+	var syncedTexts []SyncedText
+	for len(rest) > 0 {
+		j := bytes.IndexByte(rest, 0)
+		if j == -1 {
+			return nil, errors.New("SYLT frame: missing terminator for a synced text")
+		}
+		text := string(rest[:j])
+		rest = rest[j+1:]
+		if len(rest) < 4 {
+			return nil, errors.New("SYLT frame: missing timestamp for a synced text")
+		}
+		syncedTexts = append(syncedTexts, SyncedText{
+			Text:      text,
+			Timestamp: binary.BigEndian.Uint32(rest[:4]),
+		})
+		rest = rest[4:]
+	}
+
+	return SynchronisedLyricsFrame{
+		Encoding:          encoding,
+		Language:          language,
+		TimestampFormat:   timestampFormat,
+		ContentType:       contentType,
+		ContentDescriptor: contentDescriptor,
+		SyncedTexts:       syncedTexts,
+	}, nil
+}
+
+func (sylt SynchronisedLyricsFrame) Size() int {
+	size := 1 + 3 + 1 + 1 + len(sylt.ContentDescriptor) + 1
+	for _, st := range sylt.SyncedTexts {
+		size += len(st.Text) + 1 + 4
+	}
+	return size
+}
+
+func (sylt SynchronisedLyricsFrame) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+
+	fixed := append([]byte{sylt.Encoding}, []byte(sylt.Language)...)
+	fixed = append(fixed, sylt.TimestampFormat, sylt.ContentType)
+	m, err := w.Write(fixed)
+	n += int64(m)
+	if err != nil {
+		return n, err
+	}
+
+	m, err = io.WriteString(w, sylt.ContentDescriptor+"\x00")
+	n += int64(m)
+	if err != nil {
+		return n, err
+	}
+
+	for _, st := range sylt.SyncedTexts {
+		m, err = io.WriteString(w, st.Text+"\x00")
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+
+		var ts [4]byte
+		binary.BigEndian.PutUint32(ts[:], st.Timestamp)
+		m, err = w.Write(ts[:])
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestSynchronisedLyricsFrameRoundTrip(t *testing.T) {
+	want := SynchronisedLyricsFrame{
+		Encoding:          0,
+		Language:          "eng",
+		TimestampFormat:   2,
+		ContentType:       1,
+		ContentDescriptor: "lyrics",
+		SyncedTexts: []SyncedText{
+			{Text: "first line", Timestamp: 1000},
+			{Text: "second line", Timestamp: 2500},
+		},
+	}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if buf.Len() != want.Size() {
+		t.Errorf("Size() = %d, but WriteTo wrote %d bytes", want.Size(), buf.Len())
+	}
+
+	fr, err := parseSynchronisedLyricsFrame(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("parseSynchronisedLyricsFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseSynchronisedLyricsFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+func TestParseSynchronisedLyricsFrameTooShort(t *testing.T) {
+	if _, err := parseSynchronisedLyricsFrame(bytes.NewReader([]byte{0, 'e', 'n'})); err == nil {
+		t.Error("expected an error for a body shorter than the fixed fields")
+	}
+}
+
+func TestParseSynchronisedLyricsFrameMissingSyncedTextTimestamp(t *testing.T) {
+	body := []byte{0, 'e', 'n', 'g', 2, 1, 0, 'o', 'o', 'p', 's', 0, 0, 0}
+	if _, err := parseSynchronisedLyricsFrame(bytes.NewReader(body)); err == nil {
+		t.Error("expected an error for a synced text missing its 4-byte timestamp")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"github.com/bogem/id3v2/util"
+)
+
+// TableOfContentsFrame represents the CTOC frame, defined by the ID3v2
+// Chapter Addendum. It groups a set of CHAP frames (referenced by
+// ChildElementIDs) into a table of contents, and may itself contain
+// sub-frames (e.g. a TIT2 frame giving the table's title).
+type TableOfContentsFrame struct {
+	ElementID       string
+	TopLevel        bool
+	Ordered         bool
+	ChildElementIDs []string
+	SubFrames       map[string]Framer
+}
+
+func parseTableOfContentsFrame(rd io.Reader, version byte, maxFrameSize int64, unsynchronisation bool) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	i := bytes.IndexByte(data, 0)
+	if i == -1 {
+		return nil, errors.New("CTOC frame: no element ID terminator found")
+	}
+	elementID := string(data[:i])
+	rest := data[i+1:]
+	if len(rest) < 2 {
+		return nil, errors.New("CTOC frame: body is too short for its flags/entry count")
+	}
+
+	flags := rest[0]
+	entryCount := int(rest[1])
+	rest = rest[2:]
+
+	childElementIDs := make([]string, 0, entryCount)
+	for n := 0; n < entryCount; n++ {
+		j := bytes.IndexByte(rest, 0)
+		if j == -1 {
+			return nil, errors.New("CTOC frame: missing terminator for a child element ID")
+		}
+		childElementIDs = append(childElementIDs, string(rest[:j]))
+		rest = rest[j+1:]
+	}
+
+	subFrames, err := parseSubFrames(bytes.NewReader(rest), int64(len(rest)), version, maxFrameSize, unsynchronisation)
+	if err != nil {
+		return nil, err
+	}
+
+	return TableOfContentsFrame{
+		ElementID:       elementID,
+		TopLevel:        flags&0x02 != 0,
+		Ordered:         flags&0x01 != 0,
+		ChildElementIDs: childElementIDs,
+		SubFrames:       subFrames,
+	}, nil
+}
+
+func (ctoc TableOfContentsFrame) Size() int {
+	size := len(ctoc.ElementID) + 1 + 2
+	for _, id := range ctoc.ChildElementIDs {
+		size += len(id) + 1
+	}
+	for _, fr := range ctoc.SubFrames {
+		size += frameHeaderSize + fr.Size()
+	}
+	return size
+}
+
+func (ctoc TableOfContentsFrame) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+
+	m, err := io.WriteString(w, ctoc.ElementID+"\x00")
+	n += int64(m)
+	if err != nil {
+		return n, err
+	}
+
+	var flags byte
+	if ctoc.TopLevel {
+		flags |= 0x02
+	}
+	if ctoc.Ordered {
+		flags |= 0x01
+	}
+	written, err := w.Write([]byte{flags, byte(len(ctoc.ChildElementIDs))})
+	n += int64(written)
+	if err != nil {
+		return n, err
+	}
+
+	for _, id := range ctoc.ChildElementIDs {
+		m, err = io.WriteString(w, id+"\x00")
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	for id, fr := range ctoc.SubFrames {
+		if err := validateFrameID(id); err != nil {
+			return n, err
+		}
+
+		header := make([]byte, frameHeaderSize)
+		copy(header[0:4], id)
+		copy(header[4:8], util.FormSize(int64(fr.Size())))
+
+		written, err = w.Write(header)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+
+		m64, err := fr.WriteTo(w)
+		n += m64
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestTableOfContentsFrameRoundTrip(t *testing.T) {
+	want := TableOfContentsFrame{
+		ElementID:       "toc",
+		TopLevel:        true,
+		Ordered:         true,
+		ChildElementIDs: []string{"chp1", "chp2"},
+		SubFrames:       map[string]Framer{},
+	}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if buf.Len() != want.Size() {
+		t.Errorf("Size() = %d, but WriteTo wrote %d bytes", want.Size(), buf.Len())
+	}
+
+	fr, err := parseTableOfContentsFrame(bytes.NewReader(buf.Bytes()), 3, defaultMaxFrameSize, false)
+	if err != nil {
+		t.Fatalf("parseTableOfContentsFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseTableOfContentsFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+func TestTableOfContentsFrameWithSubFrames(t *testing.T) {
+	want := TableOfContentsFrame{
+		ElementID:       "toc",
+		TopLevel:        true,
+		Ordered:         false,
+		ChildElementIDs: []string{"chp1"},
+		SubFrames: map[string]Framer{
+			"UFID": UFIDFrame{OwnerIdentifier: "owner", Identifier: []byte{9}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+
+	fr, err := parseTableOfContentsFrame(bytes.NewReader(buf.Bytes()), 3, defaultMaxFrameSize, false)
+	if err != nil {
+		t.Fatalf("parseTableOfContentsFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseTableOfContentsFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+func TestParseTableOfContentsFrameMissingEntryCount(t *testing.T) {
+	if _, err := parseTableOfContentsFrame(bytes.NewReader([]byte("toc\x00\x02")), 3, defaultMaxFrameSize, false); err == nil {
+		t.Error("expected an error for a body too short for its flags/entry count")
+	}
+}
+
+func TestParseTableOfContentsFrameMissingChildTerminator(t *testing.T) {
+	body := []byte("toc\x00")
+	body = append(body, 0x03, 1) // flags, entryCount=1
+	body = append(body, []byte("chp1")...)
+	if _, err := parseTableOfContentsFrame(bytes.NewReader(body), 3, defaultMaxFrameSize, false); err == nil {
+		t.Error("expected an error for a missing child element ID terminator")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// UFIDFrame represents the UFID frame, which identifies a file with an
+// application-specific identifier issued by the owner.
+type UFIDFrame struct {
+	OwnerIdentifier string
+	Identifier      []byte
+}
+
+func parseUFIDFrame(rd io.Reader) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	i := bytes.IndexByte(data, 0)
+	if i == -1 {
+		return nil, errors.New("UFID frame: no owner identifier terminator found")
+	}
+
+	return UFIDFrame{
+		OwnerIdentifier: string(data[:i]),
+		Identifier:      data[i+1:],
+	}, nil
+}
+
+func (ufid UFIDFrame) Size() int {
+	return len(ufid.OwnerIdentifier) + 1 + len(ufid.Identifier)
+}
+
+func (ufid UFIDFrame) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, ufid.OwnerIdentifier+"\x00")
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := w.Write(ufid.Identifier)
+	return int64(n + m), err
+}
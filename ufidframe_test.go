@@ -0,0 +1,40 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestUFIDFrameRoundTrip(t *testing.T) {
+	want := UFIDFrame{
+		OwnerIdentifier: "http://example.com",
+		Identifier:      []byte{0x01, 0x02, 0x03},
+	}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if buf.Len() != want.Size() {
+		t.Errorf("Size() = %d, but WriteTo wrote %d bytes", want.Size(), buf.Len())
+	}
+
+	fr, err := parseUFIDFrame(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("parseUFIDFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseUFIDFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+func TestParseUFIDFrameMissingTerminator(t *testing.T) {
+	if _, err := parseUFIDFrame(bytes.NewReader([]byte("no terminator"))); err == nil {
+		t.Error("expected an error for a body with no owner identifier terminator")
+	}
+}
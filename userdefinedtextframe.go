@@ -0,0 +1,57 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// UserDefinedTextFrame represents the TXXX frame, a text frame with a
+// user-supplied description in addition to its value.
+type UserDefinedTextFrame struct {
+	Encoding    byte
+	Description string
+	Value       string
+}
+
+func parseUserDefinedTextFrame(rd io.Reader) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 1 {
+		return nil, errors.New("TXXX frame: body is empty")
+	}
+
+	encoding := data[0]
+	rest := data[1:]
+
+	i := bytes.IndexByte(rest, 0)
+	if i == -1 {
+		return nil, errors.New("TXXX frame: no description terminator found")
+	}
+
+	return UserDefinedTextFrame{
+		Encoding:    encoding,
+		Description: string(rest[:i]),
+		Value:       string(rest[i+1:]),
+	}, nil
+}
+
+func (txxx UserDefinedTextFrame) Size() int {
+	return 1 + len(txxx.Description) + 1 + len(txxx.Value)
+}
+
+func (txxx UserDefinedTextFrame) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write([]byte{txxx.Encoding})
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := io.WriteString(w, txxx.Description+"\x00"+txxx.Value)
+	return int64(n) + int64(m), err
+}
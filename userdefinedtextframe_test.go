@@ -0,0 +1,47 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestUserDefinedTextFrameRoundTrip(t *testing.T) {
+	want := UserDefinedTextFrame{
+		Encoding:    0,
+		Description: "replaygain_track_gain",
+		Value:       "-6.54 dB",
+	}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if buf.Len() != want.Size() {
+		t.Errorf("Size() = %d, but WriteTo wrote %d bytes", want.Size(), buf.Len())
+	}
+
+	fr, err := parseUserDefinedTextFrame(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("parseUserDefinedTextFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseUserDefinedTextFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+func TestParseUserDefinedTextFrameMissingTerminator(t *testing.T) {
+	if _, err := parseUserDefinedTextFrame(bytes.NewReader([]byte{0, 'n', 'o', 't', 'e', 'r', 'm'})); err == nil {
+		t.Error("expected an error for a body with no description terminator")
+	}
+}
+
+func TestParseUserDefinedTextFrameEmptyBody(t *testing.T) {
+	if _, err := parseUserDefinedTextFrame(bytes.NewReader(nil)); err == nil {
+		t.Error("expected an error for an empty body")
+	}
+}
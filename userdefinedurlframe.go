@@ -0,0 +1,57 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// UserDefinedURLFrame represents the WXXX frame, a URL frame with a
+// user-supplied description in addition to its value.
+type UserDefinedURLFrame struct {
+	Encoding    byte
+	Description string
+	URL         string
+}
+
+func parseUserDefinedURLFrame(rd io.Reader) (Framer, error) {
+	data, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 1 {
+		return nil, errors.New("WXXX frame: body is empty")
+	}
+
+	encoding := data[0]
+	rest := data[1:]
+
+	i := bytes.IndexByte(rest, 0)
+	if i == -1 {
+		return nil, errors.New("WXXX frame: no description terminator found")
+	}
+
+	return UserDefinedURLFrame{
+		Encoding:    encoding,
+		Description: string(rest[:i]),
+		URL:         string(rest[i+1:]),
+	}, nil
+}
+
+func (wxxx UserDefinedURLFrame) Size() int {
+	return 1 + len(wxxx.Description) + 1 + len(wxxx.URL)
+}
+
+func (wxxx UserDefinedURLFrame) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write([]byte{wxxx.Encoding})
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := io.WriteString(w, wxxx.Description+"\x00"+wxxx.URL)
+	return int64(n) + int64(m), err
+}
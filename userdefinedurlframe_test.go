@@ -0,0 +1,41 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestUserDefinedURLFrameRoundTrip(t *testing.T) {
+	want := UserDefinedURLFrame{
+		Encoding:    0,
+		Description: "artist site",
+		URL:         "https://example.com/artist",
+	}
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if buf.Len() != want.Size() {
+		t.Errorf("Size() = %d, but WriteTo wrote %d bytes", want.Size(), buf.Len())
+	}
+
+	fr, err := parseUserDefinedURLFrame(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("parseUserDefinedURLFrame: %v", err)
+	}
+	if !reflect.DeepEqual(fr, want) {
+		t.Errorf("parseUserDefinedURLFrame(WriteTo(want)) = %+v, want %+v", fr, want)
+	}
+}
+
+func TestParseUserDefinedURLFrameMissingTerminator(t *testing.T) {
+	if _, err := parseUserDefinedURLFrame(bytes.NewReader([]byte{0, 'n', 'o', 't', 'e', 'r', 'm'})); err == nil {
+		t.Error("expected an error for a body with no description terminator")
+	}
+}
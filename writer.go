@@ -0,0 +1,72 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"io"
+
+	"github.com/bogem/id3v2/util"
+)
+
+// WriteTo writes the tag header and every frame to w and returns the
+// number of bytes written. It's the counterpart of ParseReader: callers
+// that built or modified a Tag from a stream can write it back out
+// without ever touching an on-disk file.
+func (t *Tag) WriteTo(w io.Writer) (n int64, err error) {
+	written, err := w.Write(t.headerBytes())
+	n += int64(written)
+	if err != nil {
+		return n, err
+	}
+
+	for id, fr := range t.frames {
+		m, err := t.writeFrame(w, id, fr)
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+func (t *Tag) headerBytes() []byte {
+	var framesSize int64
+	for _, fr := range t.frames {
+		framesSize += frameHeaderSize + int64(fr.Size())
+	}
+
+	version := t.version
+	if version < 3 {
+		// v2.2 tags are never written back out as v2.2:
+		// parseFrameHeaderV22 already upgraded their frame IDs to
+		// v2.3, so we write the header out as v2.3 too.
+		version = 3
+	}
+
+	b := make([]byte, tagHeaderSize)
+	copy(b[0:3], "ID3")
+	b[3] = version
+	copy(b[6:10], util.FormSize(framesSize))
+	return b
+}
+
+func (t *Tag) writeFrame(w io.Writer, id string, fr Framer) (int64, error) {
+	if err := validateFrameID(id); err != nil {
+		return 0, err
+	}
+
+	header := make([]byte, frameHeaderSize)
+	copy(header[0:4], id)
+	copy(header[4:8], util.FormSize(int64(fr.Size())))
+
+	n, err := w.Write(header)
+	if err != nil {
+		return int64(n), err
+	}
+
+	m, err := fr.WriteTo(w)
+	return int64(n) + m, err
+}